@@ -0,0 +1,202 @@
+package frida
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ArtifactCache stores the compiled/snapshotted byte blobs produced by
+// CompileScript and SnapshotScript, keyed by a caller-supplied content hash.
+// Implementations must be safe for concurrent use.
+type ArtifactCache interface {
+	Get(key string) ([]byte, bool)
+	Put(key string, blob []byte)
+}
+
+// MemoryArtifactCache is an in-memory ArtifactCache that evicts the least
+// recently used entry once it holds more than capacity items.
+type MemoryArtifactCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key  string
+	blob []byte
+}
+
+// NewMemoryArtifactCache creates a MemoryArtifactCache holding at most
+// capacity entries.
+func NewMemoryArtifactCache(capacity int) *MemoryArtifactCache {
+	return &MemoryArtifactCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get implements ArtifactCache.
+func (c *MemoryArtifactCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).blob, true
+}
+
+// Put implements ArtifactCache.
+func (c *MemoryArtifactCache) Put(key string, blob []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*memoryCacheEntry).blob = blob
+		return
+	}
+
+	el := c.ll.PushFront(&memoryCacheEntry{key: key, blob: blob})
+	c.items[key] = el
+
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// FileArtifactCache is an ArtifactCache backed by one file per key under dir.
+type FileArtifactCache struct {
+	dir string
+}
+
+// NewFileArtifactCache creates a FileArtifactCache rooted at dir, creating it
+// if necessary. An empty dir defaults to $XDG_CACHE_HOME/frida-go, falling
+// back to ~/.cache/frida-go when XDG_CACHE_HOME is unset.
+func NewFileArtifactCache(dir string) (*FileArtifactCache, error) {
+	if dir == "" {
+		dir = defaultArtifactCacheDir()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileArtifactCache{dir: dir}, nil
+}
+
+func defaultArtifactCacheDir() string {
+	if xdg := os.Getenv("XDG_CACHE_HOME"); xdg != "" {
+		return filepath.Join(xdg, "frida-go")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".cache", "frida-go")
+	}
+	return filepath.Join(home, ".cache", "frida-go")
+}
+
+// Get implements ArtifactCache.
+func (c *FileArtifactCache) Get(key string) ([]byte, bool) {
+	blob, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return blob, true
+}
+
+// Put implements ArtifactCache.
+func (c *FileArtifactCache) Put(key string, blob []byte) {
+	_ = os.WriteFile(c.path(key), blob, 0o644)
+}
+
+func (c *FileArtifactCache) path(key string) string {
+	return filepath.Join(c.dir, key)
+}
+
+// artifactCacheKey derives a stable cache key from the inputs that influence
+// a compiled script or snapshot: the script bytes, the runtime it targets,
+// the warmup script (snapshots only) and the snapshot transport.
+func artifactCacheKey(script []byte, rt ScriptRuntime, warmupScript string, transport SnapshotTransport) string {
+	h := sha256.New()
+	h.Write(script)
+
+	var rtBuf [8]byte
+	binary.LittleEndian.PutUint64(rtBuf[:], uint64(rt))
+	h.Write(rtBuf[:])
+
+	h.Write([]byte(warmupScript))
+
+	var trBuf [8]byte
+	binary.LittleEndian.PutUint64(trBuf[:], uint64(transport))
+	h.Write(trBuf[:])
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CompileScriptCached compiles script the same way as CompileScript, but
+// first consults cache using a key derived from the script bytes and the
+// runtime and snapshot transport configured on opts, skipping the compile
+// step entirely on a cache hit.
+func (s *Session) CompileScriptCached(script string, opts *ScriptOptions, cache ArtifactCache) ([]byte, error) {
+	if opts == nil {
+		opts = NewScriptOptions("frida-go")
+	}
+
+	key := artifactCacheKey([]byte(script), opts.GetRuntime(), "", opts.GetSnapshotTransport())
+	if blob, ok := cache.Get(key); ok {
+		return blob, nil
+	}
+
+	blob, err := s.CompileScript(script, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.Put(key, blob)
+	return blob, nil
+}
+
+// SnapshotScriptCached snapshots embedScript the same way as SnapshotScript,
+// but first consults cache using a key derived from the embed script and the
+// warmup script and runtime configured on snapshotOpts, skipping the
+// (expensive) snapshot step entirely on a cache hit.
+func (s *Session) SnapshotScriptCached(embedScript string, snapshotOpts *SnapshotOptions, cache ArtifactCache) ([]byte, error) {
+	key := artifactCacheKey([]byte(embedScript), snapshotOpts.GetRuntime(), snapshotOpts.GetWarmupScript(), 0)
+	if blob, ok := cache.Get(key); ok {
+		return blob, nil
+	}
+
+	blob, err := s.SnapshotScript(embedScript, snapshotOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.Put(key, blob)
+	return blob, nil
+}
+
+// CreateScriptWithSnapshotCached is CreateScriptWithSnapshot with the
+// snapshot resolved through cache instead of being recomputed on every call.
+func (s *Session) CreateScriptWithSnapshotCached(script string, snapshotOpts *SnapshotOptions, cache ArtifactCache) (*Script, error) {
+	snapshot, err := s.SnapshotScriptCached(script, snapshotOpts, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := NewScriptOptions("frida-go")
+	opts.SetSnapshot(snapshot)
+	return s.CreateScriptWithOptions(script, opts)
+}
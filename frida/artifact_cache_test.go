@@ -0,0 +1,104 @@
+package frida
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMemoryArtifactCacheGetPut(t *testing.T) {
+	c := NewMemoryArtifactCache(2)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Get on empty cache returned ok=true")
+	}
+
+	c.Put("a", []byte("blob-a"))
+	blob, ok := c.Get("a")
+	if !ok || string(blob) != "blob-a" {
+		t.Fatalf("Get(%q) = %q, %v, want %q, true", "a", blob, ok, "blob-a")
+	}
+
+	c.Put("a", []byte("blob-a-updated"))
+	blob, ok = c.Get("a")
+	if !ok || string(blob) != "blob-a-updated" {
+		t.Fatalf("Get(%q) after overwrite = %q, %v, want %q, true", "a", blob, ok, "blob-a-updated")
+	}
+}
+
+func TestMemoryArtifactCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewMemoryArtifactCache(2)
+
+	c.Put("a", []byte("1"))
+	c.Put("b", []byte("2"))
+
+	// Touching "a" makes "b" the least recently used entry.
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("Get(%q) = _, false, want true", "a")
+	}
+
+	c.Put("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatalf("Get(%q) = _, true, want false (should have been evicted)", "b")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Fatalf("Get(%q) = _, false, want true", "a")
+	}
+	if _, ok := c.Get("c"); !ok {
+		t.Fatalf("Get(%q) = _, false, want true", "c")
+	}
+}
+
+func TestFileArtifactCacheGetPut(t *testing.T) {
+	c, err := NewFileArtifactCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileArtifactCache: %v", err)
+	}
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatalf("Get on empty cache returned ok=true")
+	}
+
+	c.Put("key", []byte("blob"))
+	blob, ok := c.Get("key")
+	if !ok || string(blob) != "blob" {
+		t.Fatalf("Get(%q) = %q, %v, want %q, true", "key", blob, ok, "blob")
+	}
+}
+
+func TestNewFileArtifactCacheCreatesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "cache")
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("dir %q already exists", dir)
+	}
+
+	if _, err := NewFileArtifactCache(dir); err != nil {
+		t.Fatalf("NewFileArtifactCache: %v", err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("dir %q was not created: %v", dir, err)
+	}
+}
+
+func TestArtifactCacheKeyDependsOnRuntimeAndTransport(t *testing.T) {
+	script := []byte("console.log(1)")
+
+	base := artifactCacheKey(script, 0, "", 0)
+	diffRuntime := artifactCacheKey(script, 1, "", 0)
+	diffWarmup := artifactCacheKey(script, 0, "warmup()", 0)
+	diffTransport := artifactCacheKey(script, 0, "", 1)
+
+	if base == diffRuntime {
+		t.Errorf("artifactCacheKey ignored the runtime")
+	}
+	if base == diffWarmup {
+		t.Errorf("artifactCacheKey ignored the warmup script")
+	}
+	if base == diffTransport {
+		t.Errorf("artifactCacheKey ignored the snapshot transport")
+	}
+	if got := artifactCacheKey(script, 0, "", 0); got != base {
+		t.Errorf("artifactCacheKey is not deterministic: got %q, want %q", got, base)
+	}
+}
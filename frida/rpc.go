@@ -0,0 +1,235 @@
+package frida
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Codec controls how RPCClient marshals call arguments and unmarshals call
+// results. JSONCodec is used by default, passing arguments and return values
+// through to rpc.exports exactly as real Frida scripts expect. Providing any
+// other Codec carries the encoded args/value as a base64 string instead, so
+// the script side must cooperate by decoding/encoding that convention itself.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is the default Codec, backed by encoding/json.
+type JSONCodec struct{}
+
+// Marshal implements Codec.
+func (JSONCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal implements Codec.
+func (JSONCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// rpcResult carries the outcome of a single in-flight RPC call back to the
+// goroutine blocked in Call.
+type rpcResult struct {
+	value json.RawMessage
+	err   error
+}
+
+// RPCClient bridges Go code to a script's `rpc.exports`, taking care of
+// request id allocation, reply correlation and (de)serialization so callers
+// don't have to hand-roll the `frida:rpc` message protocol themselves.
+type RPCClient struct {
+	script *Script
+	codec  Codec
+
+	nextID int64
+
+	mu      sync.Mutex
+	pending map[int64]chan rpcResult
+}
+
+var (
+	rpcClientsMu sync.Mutex
+	rpcClients   = map[*Script]*RPCClient{}
+)
+
+// RPCOption configures an RPCClient returned by Script.RPC.
+type RPCOption func(*RPCClient)
+
+// WithCodec overrides the Codec an RPCClient uses to marshal call arguments
+// and unmarshal call results. JSONCodec is used when no WithCodec option is
+// given.
+func WithCodec(codec Codec) RPCOption {
+	return func(c *RPCClient) { c.codec = codec }
+}
+
+// RPC returns the RPCClient for script, creating it and registering the
+// underlying "message" handler on first use. opts are only applied the first
+// time RPC is called for script, since the client is then shared across
+// callers.
+func (s *Script) RPC(opts ...RPCOption) *RPCClient {
+	rpcClientsMu.Lock()
+	defer rpcClientsMu.Unlock()
+
+	if c, ok := rpcClients[s]; ok {
+		return c
+	}
+
+	c := newRPCClient(s, JSONCodec{})
+	for _, opt := range opts {
+		opt(c)
+	}
+	rpcClients[s] = c
+	return c
+}
+
+// newRPCClient wires a RPCClient on top of script, using codec to
+// marshal/unmarshal call arguments and results.
+func newRPCClient(script *Script, codec Codec) *RPCClient {
+	c := &RPCClient{
+		script:  script,
+		codec:   codec,
+		pending: make(map[int64]chan rpcResult),
+	}
+	script.On("message", c.onMessage)
+	script.On("destroyed", c.onDestroyed)
+	return c
+}
+
+// onDestroyed drops script from rpcClients once it unloads, so Script.RPC
+// doesn't pin every script that ever called it alive for the life of the
+// process.
+func (c *RPCClient) onDestroyed() {
+	rpcClientsMu.Lock()
+	delete(rpcClients, c.script)
+	rpcClientsMu.Unlock()
+}
+
+// onMessage reacts to ["frida:rpc", id, "ok"|"error", value] send payloads
+// and routes them to the matching in-flight call; any other message is
+// ignored so the script's other "message" consumers keep working.
+func (c *RPCClient) onMessage(message string, data []byte) {
+	var envelope struct {
+		Type    string            `json:"type"`
+		Payload []json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal([]byte(message), &envelope); err != nil {
+		return
+	}
+	if envelope.Type != "send" || len(envelope.Payload) < 4 {
+		return
+	}
+
+	var tag string
+	if err := json.Unmarshal(envelope.Payload[0], &tag); err != nil || tag != "frida:rpc" {
+		return
+	}
+
+	var id int64
+	if err := json.Unmarshal(envelope.Payload[1], &id); err != nil {
+		return
+	}
+
+	var status string
+	if err := json.Unmarshal(envelope.Payload[2], &status); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	ch, ok := c.pending[id]
+	if ok {
+		delete(c.pending, id)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if status == "error" {
+		var errMsg string
+		_ = json.Unmarshal(envelope.Payload[3], &errMsg)
+		ch <- rpcResult{err: fmt.Errorf("frida: rpc call failed: %s", errMsg)}
+		return
+	}
+
+	if _, ok := c.codec.(JSONCodec); ok {
+		ch <- rpcResult{value: envelope.Payload[3]}
+		return
+	}
+
+	var encodedValue string
+	if err := json.Unmarshal(envelope.Payload[3], &encodedValue); err != nil {
+		ch <- rpcResult{err: fmt.Errorf("frida: rpc call failed: malformed reply value: %w", err)}
+		return
+	}
+	value, err := base64.StdEncoding.DecodeString(encodedValue)
+	if err != nil {
+		ch <- rpcResult{err: fmt.Errorf("frida: rpc call failed: malformed reply value: %w", err)}
+		return
+	}
+
+	ch <- rpcResult{value: value}
+}
+
+// Call invokes method on the script's rpc.exports with args, blocking until
+// the script replies or ctx is done.
+func (c *RPCClient) Call(ctx context.Context, method string, args ...any) (json.RawMessage, error) {
+	id := atomic.AddInt64(&c.nextID, 1)
+
+	ch := make(chan rpcResult, 1)
+	c.mu.Lock()
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	// The "frida:rpc" envelope itself is always plain JSON, matching what
+	// real Frida scripts expect. With the default JSONCodec the args are
+	// passed through unchanged; any other codec's encoded bytes are carried
+	// as a base64 string instead, since they generally aren't valid JSON on
+	// their own.
+	var argsField any = args
+	if _, ok := c.codec.(JSONCodec); !ok {
+		encodedArgs, err := c.codec.Marshal(args)
+		if err != nil {
+			c.mu.Lock()
+			delete(c.pending, id)
+			c.mu.Unlock()
+			return nil, err
+		}
+		argsField = base64.StdEncoding.EncodeToString(encodedArgs)
+	}
+
+	payload, err := json.Marshal([]any{"frida:rpc", id, "call", method, argsField})
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	if err := c.script.Post(string(payload)); err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return nil, ErrContextCancelled
+	case res := <-ch:
+		return res.value, res.err
+	}
+}
+
+// CallInto is a wrapper around Call that unmarshals the result into out using
+// the client's Codec.
+func (c *RPCClient) CallInto(ctx context.Context, method string, out any, args ...any) error {
+	raw, err := c.Call(ctx, method, args...)
+	if err != nil {
+		return err
+	}
+	return c.codec.Unmarshal(raw, out)
+}
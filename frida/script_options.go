@@ -4,7 +4,11 @@ package frida
 //#include <glib.h>
 import "C"
 import (
+	"fmt"
 	"runtime"
+	"sort"
+	"strconv"
+	"strings"
 	"unsafe"
 )
 
@@ -75,3 +79,197 @@ func (s *ScriptOptions) GetSnapshotTransport() SnapshotTransport {
 	tr := C.frida_script_options_get_snapshot_transport(s.opts)
 	return SnapshotTransport(tr)
 }
+
+// GetRuntime returns the runtime for the script.
+func (s *ScriptOptions) GetRuntime() ScriptRuntime {
+	return ScriptRuntime(C.frida_script_options_get_runtime(s.opts))
+}
+
+// SetParameters sets the parameters dictionary exposed to the script as a
+// global, letting a single compiled/snapshotted script be parameterized per
+// invocation (e.g. target addresses, feature flags) instead of
+// string-concatenating JavaScript. Values may be bool, any numeric type,
+// string, []any or nested map[string]any. Returns an error if params cannot
+// be rendered as a valid GVariant.
+func (s *ScriptOptions) SetParameters(params map[string]any) error {
+	textC := C.CString(gvariantDictText(params))
+	defer C.free(unsafe.Pointer(textC))
+
+	typeNameC := C.CString("a{sv}")
+	defer C.free(unsafe.Pointer(typeNameC))
+
+	typ := C.g_variant_type_new(typeNameC)
+	defer C.g_variant_type_free(typ)
+
+	var parseErr *C.GError
+	variant := C.g_variant_parse(typ, textC, nil, nil, &parseErr)
+	if variant == nil {
+		return handleGError(parseErr)
+	}
+	runtime.SetFinalizer(variant, func(g *C.GVariant) {
+		clean(unsafe.Pointer(g), unrefGObject)
+	})
+
+	C.frida_script_options_set_parameters(s.opts, variant)
+	runtime.KeepAlive(variant)
+	return nil
+}
+
+// GetParameters returns the parameters dictionary previously set via
+// SetParameters.
+func (s *ScriptOptions) GetParameters() map[string]any {
+	variant := C.frida_script_options_get_parameters(s.opts)
+	if variant == nil {
+		return nil
+	}
+	defer C.g_variant_unref(variant)
+
+	return gvariantToMap(variant)
+}
+
+// gvariantDictText renders params as GVariant text format of type a{sv},
+// e.g. {'foo': <1>, 'bar': <'baz'>}, suitable for g_variant_parse.
+func gvariantDictText(params map[string]any) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s: <%s>", gvariantQuoteString(k), gvariantValueText(params[k])))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// gvariantValueText renders a single Go value as a GVariant text format
+// literal, recursing into []any and map[string]any.
+func gvariantValueText(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return "@mv nothing"
+	case bool:
+		if val {
+			return "true"
+		}
+		return "false"
+	case string:
+		return gvariantQuoteString(val)
+	case int:
+		return "@x " + strconv.FormatInt(int64(val), 10)
+	case int8:
+		return "@x " + strconv.FormatInt(int64(val), 10)
+	case int16:
+		return "@x " + strconv.FormatInt(int64(val), 10)
+	case int32:
+		return "@x " + strconv.FormatInt(int64(val), 10)
+	case int64:
+		return "@x " + strconv.FormatInt(val, 10)
+	case uint:
+		return "@t " + strconv.FormatUint(uint64(val), 10)
+	case uint8:
+		return "@x " + strconv.FormatUint(uint64(val), 10)
+	case uint16:
+		return "@x " + strconv.FormatUint(uint64(val), 10)
+	case uint32:
+		return "@x " + strconv.FormatUint(uint64(val), 10)
+	case uint64:
+		return "@t " + strconv.FormatUint(val, 10)
+	case float32:
+		return "@d " + strconv.FormatFloat(float64(val), 'f', -1, 32)
+	case float64:
+		return "@d " + strconv.FormatFloat(val, 'f', -1, 64)
+	case []any:
+		if len(val) == 0 {
+			return "@av []"
+		}
+		items := make([]string, 0, len(val))
+		for _, item := range val {
+			items = append(items, "<"+gvariantValueText(item)+">")
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]any:
+		if len(val) == 0 {
+			return "@a{sv} {}"
+		}
+		return gvariantDictText(val)
+	default:
+		return gvariantQuoteString(fmt.Sprintf("%v", val))
+	}
+}
+
+// gvariantQuoteString escapes s for use as a GVariant text format string
+// literal.
+func gvariantQuoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range s {
+		if r == '\\' || r == '\'' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// gvariantToMap converts a GVariant of type a{sv} into a Go map, recursing
+// into nested a{sv} and av children.
+func gvariantToMap(dict *C.GVariant) map[string]any {
+	result := make(map[string]any)
+
+	n := C.g_variant_n_children(dict)
+	for i := C.gsize(0); i < n; i++ {
+		entry := C.g_variant_get_child_value(dict, i)
+		keyVariant := C.g_variant_get_child_value(entry, 0)
+		valueVariant := C.g_variant_get_child_value(entry, 1)
+		inner := C.g_variant_get_variant(valueVariant)
+
+		key := C.GoString(C.g_variant_get_string(keyVariant, nil))
+		result[key] = gvariantToValue(inner)
+
+		C.g_variant_unref(inner)
+		C.g_variant_unref(valueVariant)
+		C.g_variant_unref(keyVariant)
+		C.g_variant_unref(entry)
+	}
+
+	return result
+}
+
+// gvariantToValue converts a single unboxed GVariant into its Go
+// representation, based on its type string.
+func gvariantToValue(v *C.GVariant) any {
+	switch C.GoString(C.g_variant_get_type_string(v)) {
+	case "b":
+		return C.g_variant_get_boolean(v) != 0
+	case "s":
+		return C.GoString(C.g_variant_get_string(v, nil))
+	case "i":
+		return int64(C.g_variant_get_int32(v))
+	case "x":
+		return int64(C.g_variant_get_int64(v))
+	case "u":
+		return int64(C.g_variant_get_uint32(v))
+	case "t":
+		return uint64(C.g_variant_get_uint64(v))
+	case "d":
+		return float64(C.g_variant_get_double(v))
+	case "a{sv}":
+		return gvariantToMap(v)
+	case "av":
+		n := C.g_variant_n_children(v)
+		items := make([]any, 0, int(n))
+		for i := C.gsize(0); i < n; i++ {
+			item := C.g_variant_get_child_value(v, i)
+			inner := C.g_variant_get_variant(item)
+			items = append(items, gvariantToValue(inner))
+			C.g_variant_unref(inner)
+			C.g_variant_unref(item)
+		}
+		return items
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+package frida
+
+import "testing"
+
+func TestGvariantValueText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"nil", nil, "@mv nothing"},
+		{"bool true", true, "true"},
+		{"bool false", false, "false"},
+		{"string", "hello", "'hello'"},
+		{"string with quote", "it's", `'it\'s'`},
+		{"string with backslash", `a\b`, `'a\\b'`},
+		{"int", int(-5), "@x -5"},
+		{"int64", int64(1234567890123), "@x 1234567890123"},
+		{"uint", uint(5), "@t 5"},
+		{"uint32", uint32(5), "@x 5"},
+		{"uint64 large", uint64(18446744073709551615), "@t 18446744073709551615"},
+		{"float64 whole", float64(2), "@d 2"},
+		{"float64 fraction", 1.5, "@d 1.5"},
+		{"float32 whole", float32(0), "@d 0"},
+		{"empty slice", []any{}, "@av []"},
+		{"slice", []any{1, "a"}, "[<@x 1>, <'a'>]"},
+		{"nested map", map[string]any{"b": 2, "a": 1}, "{'a': <@x 1>, 'b': <@x 2>}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gvariantValueText(tt.in); got != tt.want {
+				t.Errorf("gvariantValueText(%#v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGvariantValueTextUint64AboveMaxInt64UsesUnsignedType(t *testing.T) {
+	const big uint64 = 1<<63 + 1 // overflows int64, fits uint64
+
+	got := gvariantValueText(big)
+	want := "@t 9223372036854775809"
+	if got != want {
+		t.Fatalf("gvariantValueText(%d) = %q, want %q", big, got, want)
+	}
+}
+
+func TestGvariantDictText(t *testing.T) {
+	got := gvariantDictText(map[string]any{
+		"addr":    uint64(1<<63 + 1),
+		"enabled": true,
+		"name":    "agent",
+	})
+	want := "{'addr': <@t 9223372036854775809>, 'enabled': <true>, 'name': <'agent'>}"
+	if got != want {
+		t.Fatalf("gvariantDictText(...) = %q, want %q", got, want)
+	}
+}
+
+func TestGvariantQuoteString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "''"},
+		{"plain", "'plain'"},
+		{"it's", `'it\'s'`},
+		{`back\slash`, `'back\\slash'`},
+	}
+
+	for _, tt := range tests {
+		if got := gvariantQuoteString(tt.in); got != tt.want {
+			t.Errorf("gvariantQuoteString(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -64,25 +64,130 @@ func (s *Session) detach(opts options) error {
 	return handleGError(err)
 }
 
+// ResumeCtx runs Resume but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) ResumeCtx(ctx context.Context) error {
+	done := make(chan struct{}, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		err := s.resume(c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- struct{}{}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return ErrContextCancelled
+		case <-done:
+			return nil
+		case err := <-errC:
+			return err
+		}
+	}
+}
+
 // Resume resumes the current session.
 func (s *Session) Resume() error {
+	return s.resume(nil)
+}
+
+func (s *Session) resume(cancellable *C.GCancellable) error {
 	var err *C.GError
-	C.frida_session_resume_sync(s.s, nil, &err)
+	C.frida_session_resume_sync(s.s, cancellable, &err)
 	return handleGError(err)
 }
 
+// EnableChildGatingCtx runs EnableChildGating but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) EnableChildGatingCtx(ctx context.Context) error {
+	done := make(chan struct{}, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		err := s.enableChildGating(c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- struct{}{}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return ErrContextCancelled
+		case <-done:
+			return nil
+		case err := <-errC:
+			return err
+		}
+	}
+}
+
 // EnableChildGating enables child gating on the session.
 func (s *Session) EnableChildGating() error {
+	return s.enableChildGating(nil)
+}
+
+func (s *Session) enableChildGating(cancellable *C.GCancellable) error {
 	var err *C.GError
-	C.frida_session_enable_child_gating_sync(s.s, nil, &err)
+	C.frida_session_enable_child_gating_sync(s.s, cancellable, &err)
 
 	return handleGError(err)
 }
 
+// DisableChildGatingCtx runs DisableChildGating but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) DisableChildGatingCtx(ctx context.Context) error {
+	done := make(chan struct{}, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		err := s.disableChildGating(c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- struct{}{}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return ErrContextCancelled
+		case <-done:
+			return nil
+		case err := <-errC:
+			return err
+		}
+	}
+}
+
 // DisableChildGating disables child gating on the session.
 func (s *Session) DisableChildGating() error {
+	return s.disableChildGating(nil)
+}
+
+func (s *Session) disableChildGating(cancellable *C.GCancellable) error {
 	var err *C.GError
-	C.frida_session_disable_child_gating_sync(s.s, nil, &err)
+	C.frida_session_disable_child_gating_sync(s.s, cancellable, &err)
 
 	return handleGError(err)
 }
@@ -92,8 +197,50 @@ func (s *Session) CreateScript(script string) (*Script, error) {
 	return s.CreateScriptWithOptions(script, nil)
 }
 
+// CreateScriptCtx runs CreateScript but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) CreateScriptCtx(ctx context.Context, script string) (*Script, error) {
+	return s.CreateScriptWithOptionsCtx(ctx, script, nil)
+}
+
+// CreateScriptBytesCtx runs CreateScriptBytes but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) CreateScriptBytesCtx(ctx context.Context, script []byte, opts *ScriptOptions) (*Script, error) {
+	done := make(chan *Script, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		sc, err := s.createScriptBytes(script, opts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- sc
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return nil, ErrContextCancelled
+		case sc := <-done:
+			return sc, nil
+		case err := <-errC:
+			return nil, err
+		}
+	}
+}
+
 // CreateScriptBytes is a wrapper around CreateScript(script string)
 func (s *Session) CreateScriptBytes(script []byte, opts *ScriptOptions) (*Script, error) {
+	return s.createScriptBytes(script, opts, nil)
+}
+
+func (s *Session) createScriptBytes(script []byte, opts *ScriptOptions, cancellable *C.GCancellable) (*Script, error) {
 	bts := goBytesToGBytes(script)
 	runtime.SetFinalizer(bts, func(g *C.GBytes) {
 		clean(unsafe.Pointer(g), unrefGObject)
@@ -108,7 +255,7 @@ func (s *Session) CreateScriptBytes(script []byte, opts *ScriptOptions) (*Script
 	sc := C.frida_session_create_script_from_bytes_sync(s.s,
 		bts,
 		opts.opts,
-		nil,
+		cancellable,
 		&err)
 	runtime.KeepAlive(bts)
 
@@ -123,9 +270,44 @@ func (s *Session) CreateScriptWithSnapshot(script string, snapshot []byte) (*Scr
 	return s.CreateScriptWithOptions(script, opts)
 }
 
+// CreateScriptWithOptionsCtx runs CreateScriptWithOptions but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) CreateScriptWithOptionsCtx(ctx context.Context, script string, opts *ScriptOptions) (*Script, error) {
+	done := make(chan *Script, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		sc, err := s.createScriptWithOptions(script, opts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- sc
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return nil, ErrContextCancelled
+		case sc := <-done:
+			return sc, nil
+		case err := <-errC:
+			return nil, err
+		}
+	}
+}
+
 // CreateScriptWithOptions creates the script with the script options provided.
 // Useful in cases where you previously created the snapshot.
 func (s *Session) CreateScriptWithOptions(script string, opts *ScriptOptions) (*Script, error) {
+	return s.createScriptWithOptions(script, opts, nil)
+}
+
+func (s *Session) createScriptWithOptions(script string, opts *ScriptOptions, cancellable *C.GCancellable) (*Script, error) {
 	sc := C.CString(script)
 	defer C.free(unsafe.Pointer(sc))
 
@@ -139,14 +321,49 @@ func (s *Session) CreateScriptWithOptions(script string, opts *ScriptOptions) (*
 	}
 
 	var err *C.GError
-	cScript := C.frida_session_create_script_sync(s.s, sc, opts.opts, nil, &err)
+	cScript := C.frida_session_create_script_sync(s.s, sc, opts.opts, cancellable, &err)
 	return &Script{
 		sc: cScript,
 	}, handleGError(err)
 }
 
+// CompileScriptCtx runs CompileScript but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) CompileScriptCtx(ctx context.Context, script string, opts *ScriptOptions) ([]byte, error) {
+	done := make(chan []byte, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		bts, err := s.compileScript(script, opts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- bts
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return nil, ErrContextCancelled
+		case bts := <-done:
+			return bts, nil
+		case err := <-errC:
+			return nil, err
+		}
+	}
+}
+
 // CompileScript compiles the script from the script as string provided.
 func (s *Session) CompileScript(script string, opts *ScriptOptions) ([]byte, error) {
+	return s.compileScript(script, opts, nil)
+}
+
+func (s *Session) compileScript(script string, opts *ScriptOptions, cancellable *C.GCancellable) ([]byte, error) {
 	scriptC := C.CString(script)
 	defer C.free(unsafe.Pointer(scriptC))
 
@@ -159,7 +376,7 @@ func (s *Session) CompileScript(script string, opts *ScriptOptions) ([]byte, err
 	bts := C.frida_session_compile_script_sync(s.s,
 		scriptC,
 		opts.opts,
-		nil,
+		cancellable,
 		&err,
 	)
 	if err != nil {
@@ -168,8 +385,43 @@ func (s *Session) CompileScript(script string, opts *ScriptOptions) ([]byte, err
 	return getGBytes(bts), nil
 }
 
+// SnapshotScriptCtx runs SnapshotScript but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) SnapshotScriptCtx(ctx context.Context, embedScript string, snapshotOpts *SnapshotOptions) ([]byte, error) {
+	done := make(chan []byte, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		bts, err := s.snapshotScript(embedScript, snapshotOpts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- bts
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return nil, ErrContextCancelled
+		case bts := <-done:
+			return bts, nil
+		case err := <-errC:
+			return nil, err
+		}
+	}
+}
+
 // SnapshotScript creates snapshot from the script.
 func (s *Session) SnapshotScript(embedScript string, snapshotOpts *SnapshotOptions) ([]byte, error) {
+	return s.snapshotScript(embedScript, snapshotOpts, nil)
+}
+
+func (s *Session) snapshotScript(embedScript string, snapshotOpts *SnapshotOptions, cancellable *C.GCancellable) ([]byte, error) {
 	embedScriptC := C.CString(embedScript)
 	defer C.free(unsafe.Pointer(embedScriptC))
 
@@ -178,7 +430,7 @@ func (s *Session) SnapshotScript(embedScript string, snapshotOpts *SnapshotOptio
 		s.s,
 		embedScriptC,
 		snapshotOpts.opts,
-		nil,
+		cancellable,
 		&err,
 	)
 	if err != nil {
@@ -187,20 +439,90 @@ func (s *Session) SnapshotScript(embedScript string, snapshotOpts *SnapshotOptio
 	return getGBytes(ret), nil
 }
 
+// SetupPeerConnectionCtx runs SetupPeerConnection but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) SetupPeerConnectionCtx(ctx context.Context, opts *PeerOptions) error {
+	done := make(chan struct{}, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		err := s.setupPeerConnection(opts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- struct{}{}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return ErrContextCancelled
+		case <-done:
+			return nil
+		case err := <-errC:
+			return err
+		}
+	}
+}
+
 // SetupPeerConnection sets up peer (p2p) connection with peer options provided.
 func (s *Session) SetupPeerConnection(opts *PeerOptions) error {
+	return s.setupPeerConnection(opts, nil)
+}
+
+func (s *Session) setupPeerConnection(opts *PeerOptions, cancellable *C.GCancellable) error {
 	var err *C.GError
-	C.frida_session_setup_peer_connection_sync(s.s, opts.opts, nil, &err)
+	C.frida_session_setup_peer_connection_sync(s.s, opts.opts, cancellable, &err)
 	return handleGError(err)
 }
 
+// JoinPortalCtx runs JoinPortal but with context.
+// This function will properly handle cancelling the frida operation.
+// It is advised to use this rather than handling Cancellable yourself.
+func (s *Session) JoinPortalCtx(ctx context.Context, address string, opts *PortalOptions) (*PortalMembership, error) {
+	done := make(chan *PortalMembership, 1)
+	errC := make(chan error, 1)
+
+	c := NewCancellable()
+	go func() {
+		defer c.Unref()
+		mem, err := s.joinPortal(address, opts, c.cancellable)
+		if err != nil {
+			errC <- err
+			return
+		}
+		done <- mem
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.Cancel()
+			return nil, ErrContextCancelled
+		case mem := <-done:
+			return mem, nil
+		case err := <-errC:
+			return nil, err
+		}
+	}
+}
+
 // JoinPortal joins portal at the address with portal options provided.
 func (s *Session) JoinPortal(address string, opts *PortalOptions) (*PortalMembership, error) {
+	return s.joinPortal(address, opts, nil)
+}
+
+func (s *Session) joinPortal(address string, opts *PortalOptions, cancellable *C.GCancellable) (*PortalMembership, error) {
 	addrC := C.CString(address)
 	defer C.free(unsafe.Pointer(addrC))
 
 	var err *C.GError
-	mem := C.frida_session_join_portal_sync(s.s, addrC, opts.opts, nil, &err)
+	mem := C.frida_session_join_portal_sync(s.s, addrC, opts.opts, cancellable, &err)
 
 	return &PortalMembership{mem}, handleGError(err)
 }